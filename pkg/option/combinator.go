@@ -0,0 +1,90 @@
+package option
+
+// FlatMap transforms an Option[T] into an Option[U] by applying a conversion
+// that itself returns an Option[U], flattening the result instead of nesting
+// it the way [Map] would. None[T] is returned as None[U].
+//
+// # Errors
+//   - Any error returned by the conversion.
+func FlatMap[T any, U any](opt Option[T], convert func(T) (Option[U], error)) (Option[U], error) {
+	if !opt.Some() {
+		return None[U](), nil
+	}
+
+	u, err := convert(opt.ValueOrZero())
+	if err != nil {
+		return None[U](), err
+	}
+
+	return u, nil
+}
+
+// Filter returns opt unchanged if it is None or if predicate returns true for
+// its value, and None otherwise.
+func Filter[T any](opt Option[T], predicate func(T) bool) Option[T] {
+	if !opt.Some() {
+		return opt
+	}
+
+	if !predicate(opt.ValueOrZero()) {
+		return None[T]()
+	}
+
+	return opt
+}
+
+// Match applies someFn to the value of a Some Option or calls noneFn for a
+// None Option, unifying both branches into a single result of type U.
+func Match[T any, U any](opt Option[T], someFn func(T) U, noneFn func() U) U {
+	if !opt.Some() {
+		return noneFn()
+	}
+
+	return someFn(opt.ValueOrZero())
+}
+
+// Or returns o if it is Some, and other otherwise.
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	if o.Some() {
+		return o
+	}
+
+	return other
+}
+
+// And returns other if o is Some, and None otherwise.
+func (o Option[T]) And(other Option[T]) Option[T] {
+	if !o.Some() {
+		return None[T]()
+	}
+
+	return other
+}
+
+// Tuple pairs two values of possibly different types.
+type Tuple[T any, U any] struct {
+	First  T
+	Second U
+}
+
+// Zip combines two Options into an Option of a [Tuple], which is Some only if
+// both a and b are Some.
+func Zip[T any, U any](a Option[T], b Option[U]) Option[Tuple[T, U]] {
+	if !a.Some() || !b.Some() {
+		return None[Tuple[T, U]]()
+	}
+
+	return Some(Tuple[T, U]{First: a.ValueOrZero(), Second: b.ValueOrZero()})
+}
+
+// Unzip splits an Option[Tuple[T, U]] into its component Options. A None
+// input produces two None outputs.
+func Unzip[T any, U any](opt Option[Tuple[T, U]]) (Option[T], Option[U]) {
+	if !opt.Some() {
+		return None[T](), None[U]()
+	}
+
+	tuple := opt.ValueOrZero()
+
+	return Some(tuple.First), Some(tuple.Second)
+}
@@ -0,0 +1,185 @@
+package option
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	double := func(i int) (Option[int], error) { return Some(i * 2), nil }
+	errConvert := errors.New("convert failed")
+
+	cases := []struct {
+		name    string
+		opt     Option[int]
+		convert func(int) (Option[int], error)
+		want    Option[int]
+		wantErr error
+	}{
+		{"None short-circuits", None[int](), double, None[int](), nil},
+		{"Some applies conversion", Some(21), double, Some(42), nil},
+		{"Some propagates error", Some(21), func(int) (Option[int], error) { return None[int](), errConvert }, None[int](), errConvert},
+		{"conversion can return None", Some(21), func(int) (Option[int], error) { return None[int](), nil }, None[int](), nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FlatMap(tc.opt, tc.convert)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tc.wantErr)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("FlatMap() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	cases := []struct {
+		name string
+		opt  Option[int]
+		want Option[int]
+	}{
+		{"None stays None", None[int](), None[int]()},
+		{"Some matching predicate stays Some", Some(42), Some(42)},
+		{"Some failing predicate becomes None", Some(41), None[int]()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Filter(tc.opt, isEven); !got.Equal(tc.want) {
+				t.Errorf("Filter() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	someFn := func(i int) string { return "some" }
+	noneFn := func() string { return "none" }
+
+	if got := Match(Some(42), someFn, noneFn); got != "some" {
+		t.Errorf("Match(Some) = %q, want %q", got, "some")
+	}
+	if got := Match(None[int](), someFn, noneFn); got != "none" {
+		t.Errorf("Match(None) = %q, want %q", got, "none")
+	}
+}
+
+func TestOption_Or(t *testing.T) {
+	cases := []struct {
+		name  string
+		o     Option[int]
+		other Option[int]
+		want  Option[int]
+	}{
+		{"Some or Some returns receiver", Some(1), Some(2), Some(1)},
+		{"Some or None returns receiver", Some(1), None[int](), Some(1)},
+		{"None or Some returns other", None[int](), Some(2), Some(2)},
+		{"None or None stays None", None[int](), None[int](), None[int]()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.o.Or(tc.other); !got.Equal(tc.want) {
+				t.Errorf("Or() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOption_And(t *testing.T) {
+	cases := []struct {
+		name  string
+		o     Option[int]
+		other Option[int]
+		want  Option[int]
+	}{
+		{"Some and Some returns other", Some(1), Some(2), Some(2)},
+		{"Some and None returns None", Some(1), None[int](), None[int]()},
+		{"None and Some stays None", None[int](), Some(2), None[int]()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.o.And(tc.other); !got.Equal(tc.want) {
+				t.Errorf("And() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	cases := []struct {
+		name string
+		a    Option[int]
+		b    Option[string]
+		want Option[Tuple[int, string]]
+	}{
+		{"both Some zip to Some", Some(1), Some("a"), Some(Tuple[int, string]{1, "a"})},
+		{"a None zips to None", None[int](), Some("a"), None[Tuple[int, string]]()},
+		{"b None zips to None", Some(1), None[string](), None[Tuple[int, string]]()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zipped := Zip(tc.a, tc.b)
+			if zipped.Some() != tc.want.Some() {
+				t.Fatalf("Zip() = %v, want %v", zipped, tc.want)
+			}
+			if zipped.Some() && zipped.ValueOrZero() != tc.want.ValueOrZero() {
+				t.Errorf("Zip() = %v, want %v", zipped, tc.want)
+			}
+
+			// Unzip can only recover what Zip preserved: both values if the
+			// pair was Some, and None for both otherwise, even if one of
+			// the original inputs was itself Some.
+			wantA, wantB := None[int](), None[string]()
+			if tc.want.Some() {
+				wantA, wantB = tc.a, tc.b
+			}
+
+			a, b := Unzip(zipped)
+			if a.Some() != wantA.Some() || (a.Some() && a.ValueOrZero() != wantA.ValueOrZero()) {
+				t.Errorf("Unzip() first = %v, want %v", a, wantA)
+			}
+			if b.Some() != wantB.Some() || (b.Some() && b.ValueOrZero() != wantB.ValueOrZero()) {
+				t.Errorf("Unzip() second = %v, want %v", b, wantB)
+			}
+		})
+	}
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	opt := Some(21)
+	double := func(i int) (Option[int], error) { return Some(i * 2), nil }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FlatMap(opt, double)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	opt := Some(42)
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Filter(opt, isEven)
+	}
+}
+
+func BenchmarkMatch(b *testing.B) {
+	opt := Some(42)
+	someFn := func(i int) int { return i }
+	noneFn := func() int { return 0 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Match(opt, someFn, noneFn)
+	}
+}
@@ -0,0 +1,68 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOption_Equal(t *testing.T) {
+	cases := []struct {
+		name string
+		a    Option[int]
+		b    Option[int]
+		want bool
+	}{
+		{"both None are equal", None[int](), None[int](), true},
+		{"equal Some values are equal", Some(42), Some(42), true},
+		{"unequal Some values are unequal", Some(42), Some(7), false},
+		{"Some and None are unequal", Some(42), None[int](), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.a.Equal(tc.b); got != tc.want {
+				t.Errorf("Equal() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOption_EqualFunc(t *testing.T) {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+
+	if !EqualFunc(Some("ab"), Some("cd"), sameLength) {
+		t.Error("EqualFunc(Some(\"ab\"), Some(\"cd\")) = false, want true")
+	}
+	if EqualFunc(Some("ab"), Some("abc"), sameLength) {
+		t.Error("EqualFunc(Some(\"ab\"), Some(\"abc\")) = true, want false")
+	}
+	if !EqualFunc(None[string](), None[string](), sameLength) {
+		t.Error("EqualFunc(None, None) = false, want true")
+	}
+	if EqualFunc(Some("ab"), None[string](), sameLength) {
+		t.Error("EqualFunc(Some, None) = true, want false")
+	}
+}
+
+// dto mimics the shape of the realworld DTOs that motivated Equal: a struct
+// with nested Option fields that cmp.Diff should be able to compare without
+// unpacking each field by hand.
+type dto struct {
+	Bio   Option[string]
+	Image Option[string]
+}
+
+func TestOption_CmpDiff(t *testing.T) {
+	a := dto{Bio: Some("hello"), Image: None[string]()}
+	b := dto{Bio: Some("hello"), Image: None[string]()}
+
+	if diff := cmp.Diff(a, b); diff != "" {
+		t.Errorf("expected no diff between identical DTOs, got:\n%s", diff)
+	}
+
+	c := dto{Bio: Some("goodbye"), Image: None[string]()}
+	if diff := cmp.Diff(a, c); diff == "" {
+		t.Error("expected a diff between DTOs with different Bio, got none")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 )
 
 // Option represents an optional type.
@@ -23,8 +24,18 @@ func (o Option[T]) String() string {
 	return "None"
 }
 
+// UnmarshalJSON implements [json.Unmarshaler]. The JSON literal `null`
+// unmarshals to None; any other value unmarshals to Some via json.Unmarshal
+// into the underlying T.
+//
+// Limitation: because None also marshals to `null`, a Some wrapping a value
+// that itself marshals to `null` — e.g. Some[*Foo](nil), or Some(None[U]())
+// — is indistinguishable from None once round-tripped through JSON; it
+// comes back as None. Avoid constructing a Some over such a value where the
+// distinction matters.
 func (o *Option[T]) UnmarshalJSON(bytes []byte) error {
-	if len(bytes) == 0 {
+	if len(bytes) == 0 || string(bytes) == "null" {
+		*o = None[T]()
 		return nil
 	}
 
@@ -37,6 +48,26 @@ func (o *Option[T]) UnmarshalJSON(bytes []byte) error {
 	return nil
 }
 
+// MarshalJSON implements [json.Marshaler]. A None Option marshals to the
+// JSON literal `null`; a Some Option marshals to the JSON encoding of its
+// underlying value, even when that value is itself the zero value of T.
+//
+// See the limitation documented on [Option.UnmarshalJSON]: this means a
+// Some wrapping a value that itself marshals to `null` is indistinguishable
+// from None on the wire.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.some {
+		return []byte("null"), nil
+	}
+
+	bytes, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, err // nolint:wrapcheck
+	}
+
+	return bytes, nil
+}
+
 // Some returns an Option[T] populated with the given type.
 func Some[T any](value T) Option[T] {
 	return Option[T]{
@@ -56,13 +87,43 @@ func (o Option[T]) Some() bool {
 	return o.some
 }
 
-// ErrEmptyOption is returned by [Option.Value] when attempting to retrieve a
+// Equal reports whether o and other are both None, or both Some with
+// [reflect.DeepEqual] values. Implementing Equal allows [cmp.Diff] to compare
+// Options field-by-field without unpacking them manually.
+func (o Option[T]) Equal(other Option[T]) bool {
+	if o.some != other.some {
+		return false
+	}
+	if !o.some {
+		return true
+	}
+	return reflect.DeepEqual(o.value, other.value)
+}
+
+// EqualFunc reports whether a and b are both None, or both Some with values
+// considered equal by eq. Use EqualFunc for types whose own Equal method
+// should be preferred over [reflect.DeepEqual].
+func EqualFunc[T any](a, b Option[T], eq func(T, T) bool) bool {
+	if a.some != b.some {
+		return false
+	}
+	if !a.some {
+		return true
+	}
+	return eq(a.value, b.value)
+}
+
+// ErrEmptyOption is returned by [Option.Get] when attempting to retrieve a
 // value from an empty Option.
 var ErrEmptyOption = errors.New("expected Option value was empty")
 
-// Value returns the value of the [Option], or [ErrEmptyOption] error if the
+// Get returns the value of the [Option], or [ErrEmptyOption] error if the
 // Option is empty.
-func (o Option[T]) Value() (T, error) {
+//
+// Get was previously named Value; that name is now used by [Option.Value]
+// to satisfy [driver.Valuer] instead. Callers migrating from the old
+// signature should replace opt.Value() with opt.Get().
+func (o Option[T]) Get() (T, error) {
 	if !o.Some() {
 		return *new(T), ErrEmptyOption
 	}
@@ -75,6 +136,44 @@ func (o Option[T]) ValueOrZero() T {
 	return o.value
 }
 
+// ValueOr returns the value of the [Option], or fallback if the Option is
+// None.
+func (o Option[T]) ValueOr(fallback T) T {
+	if !o.some {
+		return fallback
+	}
+	return o.value
+}
+
+// OrElse returns the value of the [Option], or the result of calling fallback
+// if the Option is None. Unlike [Option.ValueOr], fallback is only evaluated
+// when needed.
+func (o Option[T]) OrElse(fallback func() T) T {
+	if !o.some {
+		return fallback()
+	}
+	return o.value
+}
+
+// FromPtr returns None if ptr is nil, and Some of the dereferenced value
+// otherwise.
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+	return Some(*ptr)
+}
+
+// Ptr returns nil if the [Option] is None, and a pointer to a copy of its
+// value otherwise. The internal field backing the Option is never exposed.
+func (o Option[T]) Ptr() *T {
+	if !o.some {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
 // Conversion is a function that converts a T into a U.
 type Conversion[T any, U any] func(T) (U, error)
 
@@ -0,0 +1,59 @@
+package option
+
+import "testing"
+
+func TestFromPtr(t *testing.T) {
+	if got := FromPtr[int](nil); got.Some() {
+		t.Errorf("FromPtr(nil) = %v, want None", got)
+	}
+
+	value := 42
+	if got := FromPtr(&value); got.ValueOrZero() != 42 {
+		t.Errorf("FromPtr(&42) = %v, want Some(42)", got)
+	}
+}
+
+func TestOption_Ptr(t *testing.T) {
+	if got := None[int]().Ptr(); got != nil {
+		t.Errorf("None[int]().Ptr() = %v, want nil", got)
+	}
+
+	opt := Some(42)
+	ptr := opt.Ptr()
+	if ptr == nil || *ptr != 42 {
+		t.Fatalf("Some(42).Ptr() = %v, want pointer to 42", ptr)
+	}
+
+	// Mutating through the returned pointer must not reach back into the
+	// Option's internal field.
+	*ptr = 7
+	if got, _ := opt.Get(); got != 42 {
+		t.Errorf("Ptr() leaked the internal field: opt.Get() = %v after mutating *Ptr()", got)
+	}
+}
+
+func TestOption_ValueOr(t *testing.T) {
+	if got := None[int]().ValueOr(99); got != 99 {
+		t.Errorf("None[int]().ValueOr(99) = %v, want 99", got)
+	}
+	if got := Some(42).ValueOr(99); got != 42 {
+		t.Errorf("Some(42).ValueOr(99) = %v, want 42", got)
+	}
+}
+
+func TestOption_OrElse(t *testing.T) {
+	called := false
+	fallback := func() int {
+		called = true
+		return 99
+	}
+
+	if got := Some(42).OrElse(fallback); got != 42 || called {
+		t.Errorf("Some(42).OrElse(fallback) = %v, called=%v, want 42, false", got, called)
+	}
+
+	called = false
+	if got := None[int]().OrElse(fallback); got != 99 || !called {
+		t.Errorf("None[int]().OrElse(fallback) = %v, called=%v, want 99, true", got, called)
+	}
+}
@@ -0,0 +1,170 @@
+package option
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Scan implements [sql.Scanner], allowing an Option to be populated directly
+// from a database driver value, as a drop-in replacement for sql.NullString,
+// sql.NullInt64, sql.NullTime, etc. A nil src yields None; any other src is
+// converted to T by a fixed set of conversions: string and []byte targets
+// accept string or []byte, integer targets accept only int64, bool accepts
+// bool or int64 (non-zero is true), float64 accepts float64 or int64, and
+// time.Time accepts only time.Time. Unlike the sql.Null* types, numeric
+// text such as []byte("42") is not parsed into a number. Any other T falls
+// back to JSON decoding of a string or []byte src, which covers structs,
+// slices and maps.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		*o = None[T]()
+		return nil
+	}
+
+	if err := scanInto(&o.value, src); err != nil {
+		return err
+	}
+
+	o.some = true
+
+	return nil
+}
+
+// scanInto converts a database driver value into dst, which is always a
+// pointer to the T backing an Option[T].
+func scanInto(dst any, src any) error {
+	switch d := dst.(type) {
+	case *string:
+		switch s := src.(type) {
+		case string:
+			*d = s
+		case []byte:
+			*d = string(s)
+		default:
+			return fmt.Errorf("option: cannot scan %T into string", src)
+		}
+	case *bool:
+		switch b := src.(type) {
+		case bool:
+			*d = b
+		case int64:
+			// Some drivers (e.g. MySQL's TINYINT(1) columns) surface
+			// booleans as integers rather than converting them to bool.
+			*d = b != 0
+		default:
+			return fmt.Errorf("option: cannot scan %T into bool", src)
+		}
+	case *int:
+		i, err := scanInt64(src)
+		if err != nil {
+			return err
+		}
+		*d = int(i)
+	case *int16:
+		i, err := scanInt64(src)
+		if err != nil {
+			return err
+		}
+		*d = int16(i)
+	case *int32:
+		i, err := scanInt64(src)
+		if err != nil {
+			return err
+		}
+		*d = int32(i)
+	case *int64:
+		i, err := scanInt64(src)
+		if err != nil {
+			return err
+		}
+		*d = i
+	case *uint8:
+		i, err := scanInt64(src)
+		if err != nil {
+			return err
+		}
+		*d = uint8(i)
+	case *float64:
+		switch f := src.(type) {
+		case float64:
+			*d = f
+		case int64:
+			*d = float64(f)
+		default:
+			return fmt.Errorf("option: cannot scan %T into float64", src)
+		}
+	case *time.Time:
+		t, ok := src.(time.Time)
+		if !ok {
+			return fmt.Errorf("option: cannot scan %T into time.Time", src)
+		}
+		*d = t
+	case *[]byte:
+		switch b := src.(type) {
+		case []byte:
+			*d = append([]byte(nil), b...)
+		case string:
+			*d = []byte(b)
+		default:
+			return fmt.Errorf("option: cannot scan %T into []byte", src)
+		}
+	default:
+		raw, ok := src.([]byte)
+		if !ok {
+			s, ok := src.(string)
+			if !ok {
+				return fmt.Errorf("option: cannot scan %T into %T", src, dst)
+			}
+			raw = []byte(s)
+		}
+		if err := json.Unmarshal(raw, dst); err != nil {
+			return fmt.Errorf("option: json fallback: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func scanInt64(src any) (int64, error) {
+	i, ok := src.(int64)
+	if !ok {
+		return 0, fmt.Errorf("option: cannot scan %T into integer", src)
+	}
+	return i, nil
+}
+
+// Value implements [driver.Valuer]. A None Option yields nil; a Some Option
+// yields its underlying value, converted to one of the types
+// database/sql/driver natively accepts. Types it does not natively accept
+// are marshaled to JSON.
+//
+// Value shares its name with the pre-existing accessor that returned
+// (T, error); that accessor is now named [Option.Get].
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.some {
+		return nil, nil
+	}
+
+	switch v := any(o.value).(type) {
+	case string, bool, float64, time.Time, []byte:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	default:
+		bytes, err := json.Marshal(o.value)
+		if err != nil {
+			return nil, fmt.Errorf("option: json fallback: %w", err)
+		}
+		return bytes, nil
+	}
+}
@@ -0,0 +1,114 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOption_Value(t *testing.T) {
+	if v, err := None[int]().Value(); err != nil || v != nil {
+		t.Fatalf("None[int]().Value() = %v, %v, want nil, nil", v, err)
+	}
+
+	if got, err := Some(42).Value(); err != nil || got != int64(42) {
+		t.Errorf("Some(42).Value() = %#v, %v, want int64(42), nil", got, err)
+	}
+	if got, err := Some(int16(42)).Value(); err != nil || got != int64(42) {
+		t.Errorf("Some(int16(42)).Value() = %#v, %v, want int64(42), nil", got, err)
+	}
+	if got, err := Some(int32(42)).Value(); err != nil || got != int64(42) {
+		t.Errorf("Some(int32(42)).Value() = %#v, %v, want int64(42), nil", got, err)
+	}
+	if got, err := Some(int64(42)).Value(); err != nil || got != int64(42) {
+		t.Errorf("Some(int64(42)).Value() = %#v, %v, want int64(42), nil", got, err)
+	}
+	if got, err := Some(uint8(42)).Value(); err != nil || got != int64(42) {
+		t.Errorf("Some(uint8(42)).Value() = %#v, %v, want int64(42), nil", got, err)
+	}
+	if got, err := Some("hello").Value(); err != nil || got != "hello" {
+		t.Errorf(`Some("hello").Value() = %#v, %v, want "hello", nil`, got, err)
+	}
+	if got, err := Some(true).Value(); err != nil || got != true {
+		t.Errorf("Some(true).Value() = %#v, %v, want true, nil", got, err)
+	}
+}
+
+func TestOption_Scan(t *testing.T) {
+	t.Run("nil src yields None", func(t *testing.T) {
+		opt := Some(42)
+		if err := opt.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil): %v", err)
+		}
+		if opt.Some() {
+			t.Errorf("Scan(nil) left opt Some: %v", opt)
+		}
+	})
+
+	t.Run("int from int64", func(t *testing.T) {
+		var opt Option[int]
+		if err := opt.Scan(int64(42)); err != nil {
+			t.Fatalf("Scan(int64(42)): %v", err)
+		}
+		if got, _ := opt.Get(); got != 42 {
+			t.Errorf("Scan(int64(42)) = %v, want 42", got)
+		}
+	})
+
+	t.Run("bool from bool", func(t *testing.T) {
+		var opt Option[bool]
+		if err := opt.Scan(true); err != nil {
+			t.Fatalf("Scan(true): %v", err)
+		}
+		if got, _ := opt.Get(); got != true {
+			t.Errorf("Scan(true) = %v, want true", got)
+		}
+	})
+
+	t.Run("bool from TINYINT(1)-style int64", func(t *testing.T) {
+		var opt Option[bool]
+		if err := opt.Scan(int64(1)); err != nil {
+			t.Fatalf("Scan(int64(1)): %v", err)
+		}
+		if got, _ := opt.Get(); got != true {
+			t.Errorf("Scan(int64(1)) = %v, want true", got)
+		}
+
+		if err := opt.Scan(int64(0)); err != nil {
+			t.Fatalf("Scan(int64(0)): %v", err)
+		}
+		if got, _ := opt.Get(); got != false {
+			t.Errorf("Scan(int64(0)) = %v, want false", got)
+		}
+	})
+
+	t.Run("string from []byte", func(t *testing.T) {
+		var opt Option[string]
+		if err := opt.Scan([]byte("hello")); err != nil {
+			t.Fatalf("Scan([]byte(\"hello\")): %v", err)
+		}
+		if got, _ := opt.Get(); got != "hello" {
+			t.Errorf("Scan([]byte(\"hello\")) = %v, want hello", got)
+		}
+	})
+
+	t.Run("time.Time from time.Time", func(t *testing.T) {
+		now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		var opt Option[time.Time]
+		if err := opt.Scan(now); err != nil {
+			t.Fatalf("Scan(time.Time): %v", err)
+		}
+		if got, _ := opt.Get(); !got.Equal(now) {
+			t.Errorf("Scan(time.Time) = %v, want %v", got, now)
+		}
+	})
+
+	t.Run("JSON fallback for structs", func(t *testing.T) {
+		var opt Option[Foo]
+		if err := opt.Scan([]byte(`{"Name":"bar"}`)); err != nil {
+			t.Fatalf("Scan(JSON): %v", err)
+		}
+		if got, _ := opt.Get(); got.Name != "bar" {
+			t.Errorf("Scan(JSON) = %v, want Name=bar", got)
+		}
+	})
+}
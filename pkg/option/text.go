@@ -0,0 +1,157 @@
+package option
+
+import (
+	"encoding"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// MarshalText implements [encoding.TextMarshaler]. A None Option marshals to
+// an empty byte slice; a Some Option marshals via T's own MarshalText method
+// if T implements [encoding.TextMarshaler], falling back to a plain-text
+// encoding for strings, bools and numeric primitives.
+//
+// Limitation: plain text has no sentinel that means "absent" the way JSON
+// has `null`, so Some(the zero value that marshals to empty text, e.g. "")
+// is indistinguishable from None once encoded — unlike [Option.MarshalJSON]
+// and [Option.Value]/[Option.Scan], which both preserve the distinction.
+// Callers that need to tell "explicitly empty" apart from "unset" over a
+// text-only channel, such as an environment variable, should check for
+// presence themselves rather than relying on this method; see [ParseEnv].
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.some {
+		return []byte{}, nil
+	}
+	return marshalText(o.value)
+}
+
+func marshalText(value any) ([]byte, error) {
+	if m, ok := value.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case bool:
+		return strconv.AppendBool(nil, v), nil
+	case int:
+		return strconv.AppendInt(nil, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(nil, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(nil, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(nil, v, 10), nil
+	case uint8:
+		return strconv.AppendUint(nil, uint64(v), 10), nil
+	case float64:
+		return strconv.AppendFloat(nil, v, 'f', -1, 64), nil
+	default:
+		return nil, fmt.Errorf("option: %T does not implement encoding.TextMarshaler and is not a supported primitive", value)
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. Empty text is treated
+// as None; any other text populates the Option via T's own UnmarshalText
+// method if T implements [encoding.TextUnmarshaler], falling back to a
+// plain-text decoding for strings, bools and numeric primitives.
+//
+// Limitation: because empty text is always treated as None, a Some holding
+// the zero value that marshals to empty text (e.g. "") cannot round-trip
+// through this method — see the equivalent note on [Option.MarshalText].
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = None[T]()
+		return nil
+	}
+
+	if err := unmarshalText(&o.value, text); err != nil {
+		return err
+	}
+
+	o.some = true
+
+	return nil
+}
+
+func unmarshalText(dst any, text []byte) error {
+	if u, ok := dst.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+
+	switch d := dst.(type) {
+	case *string:
+		*d = string(text)
+	case *bool:
+		b, err := strconv.ParseBool(string(text))
+		if err != nil {
+			return fmt.Errorf("option: parse bool: %w", err)
+		}
+		*d = b
+	case *int:
+		i, err := strconv.ParseInt(string(text), 10, 64)
+		if err != nil {
+			return fmt.Errorf("option: parse int: %w", err)
+		}
+		*d = int(i)
+	case *int16:
+		i, err := strconv.ParseInt(string(text), 10, 16)
+		if err != nil {
+			return fmt.Errorf("option: parse int16: %w", err)
+		}
+		*d = int16(i)
+	case *int32:
+		i, err := strconv.ParseInt(string(text), 10, 32)
+		if err != nil {
+			return fmt.Errorf("option: parse int32: %w", err)
+		}
+		*d = int32(i)
+	case *int64:
+		i, err := strconv.ParseInt(string(text), 10, 64)
+		if err != nil {
+			return fmt.Errorf("option: parse int64: %w", err)
+		}
+		*d = i
+	case *uint8:
+		u, err := strconv.ParseUint(string(text), 10, 8)
+		if err != nil {
+			return fmt.Errorf("option: parse uint8: %w", err)
+		}
+		*d = uint8(u)
+	case *float64:
+		f, err := strconv.ParseFloat(string(text), 64)
+		if err != nil {
+			return fmt.Errorf("option: parse float64: %w", err)
+		}
+		*d = f
+	default:
+		return fmt.Errorf("option: %T does not implement encoding.TextUnmarshaler and is not a supported primitive", dst)
+	}
+
+	return nil
+}
+
+// ParseEnv returns None if the named environment variable is unset, and
+// Some of its parsed value otherwise, distinguishing an unset variable from
+// one that is set but empty. ParseEnv is the preferred way to model optional
+// configuration — a JWT key path, an SMTP host, a log level override —
+// without resorting to sentinel empty strings.
+//
+// # Errors
+//   - Any error returned while parsing a set variable as T.
+func ParseEnv[T any](name string) (Option[T], error) {
+	raw, ok := os.LookupEnv(name)
+	if !ok {
+		return None[T](), nil
+	}
+
+	var opt Option[T]
+	if err := unmarshalText(&opt.value, []byte(raw)); err != nil {
+		return None[T](), fmt.Errorf("option: parse env var %s: %w", name, err)
+	}
+	opt.some = true
+
+	return opt, nil
+}
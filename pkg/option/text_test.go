@@ -0,0 +1,105 @@
+package option
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseEnv(t *testing.T) {
+	const name = "OPTION_TEST_PARSE_ENV"
+
+	t.Run("unset yields None", func(t *testing.T) {
+		// t.Setenv always sets the variable for the duration of the test and
+		// restores its prior value afterwards, which also covers unsetting
+		// it cleanly on exit.
+		t.Setenv(name, "")
+		if err := os.Unsetenv(name); err != nil {
+			t.Fatalf("Unsetenv: %v", err)
+		}
+
+		got, err := ParseEnv[string](name)
+		if err != nil {
+			t.Fatalf("ParseEnv: %v", err)
+		}
+		if got.Some() {
+			t.Errorf("ParseEnv(unset) = %v, want None", got)
+		}
+	})
+
+	t.Run("set but empty yields Some empty value", func(t *testing.T) {
+		t.Setenv(name, "")
+
+		got, err := ParseEnv[string](name)
+		if err != nil {
+			t.Fatalf("ParseEnv: %v", err)
+		}
+		if !got.Some() || got.ValueOrZero() != "" {
+			t.Errorf("ParseEnv(empty-but-set) = %v, want Some(\"\")", got)
+		}
+	})
+
+	t.Run("set and non-empty yields parsed value", func(t *testing.T) {
+		t.Setenv(name, "42")
+
+		got, err := ParseEnv[int](name)
+		if err != nil {
+			t.Fatalf("ParseEnv: %v", err)
+		}
+		if got.ValueOrZero() != 42 {
+			t.Errorf("ParseEnv(\"42\") = %v, want Some(42)", got)
+		}
+	})
+
+	t.Run("malformed value surfaces an error", func(t *testing.T) {
+		t.Setenv(name, "not-a-number")
+
+		got, err := ParseEnv[int](name)
+		if err == nil {
+			t.Fatalf("ParseEnv(malformed) = %v, nil, want an error", got)
+		}
+	})
+}
+
+func TestOption_TextRoundTrip(t *testing.T) {
+	opt := Some(42)
+	text, err := opt.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+
+	var got Option[int]
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if got.ValueOrZero() != 42 {
+		t.Errorf("round trip = %v, want Some(42)", got)
+	}
+}
+
+// TestOption_MarshalText_EmptyValueAmbiguity locks in the documented
+// limitation: unlike the JSON encoding, the text encoding cannot
+// distinguish a Some holding a value that marshals to empty text from
+// None, since plain text has no `null`-equivalent sentinel.
+func TestOption_MarshalText_EmptyValueAmbiguity(t *testing.T) {
+	some, err := Some("").MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(Some(\"\")): %v", err)
+	}
+
+	none, err := None[string]().MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(None): %v", err)
+	}
+
+	if string(some) != string(none) {
+		t.Fatalf("expected Some(\"\") and None to collide, got %q != %q", some, none)
+	}
+
+	var got Option[string]
+	if err := got.UnmarshalText(some); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if got.Some() {
+		t.Fatalf("UnmarshalText(empty text) = %v, want None (documented limitation)", got)
+	}
+}